@@ -0,0 +1,122 @@
+package svg
+
+import "fmt"
+
+// Point is a two-dimensional coordinate used as a keyframe value for
+// the AnimateTranslate, AnimateRotate, AnimateScale, AnimateSkewX,
+// and AnimateSkewY methods.
+type Point struct {
+	X float
+	Y float
+}
+
+// Animate animates the named attribute attr of the element referenced
+// by link from from to to over dur milliseconds, repeating repeat
+// times (0 means repeat indefinitely).
+// Standard Reference: http://www.w3.org/TR/SVG11/animate.html#AnimateElement
+func (svg *SVG) Animate(link string, attr string, from int, to int, dur int, repeat int) {
+	svg.printf(`<animate %s attributeName="%s" from="%d" to="%d" dur="%dms" repeatCount="%s"/>`,
+		svg.href(link), attr, from, to, dur, svg.repeatcount(repeat))
+	svg.println()
+}
+
+// AnimateMotion animates the element referenced by link along path
+// over dur milliseconds, repeating repeat times (0 means indefinitely).
+// Standard Reference: http://www.w3.org/TR/SVG11/animate.html#AnimateMotionElement
+func (svg *SVG) AnimateMotion(link string, path string, dur int, repeat int) {
+	svg.printf(`<animateMotion %s dur="%dms" repeatCount="%s"><mpath %s/></animateMotion>`,
+		svg.href(link), dur, svg.repeatcount(repeat), svg.href(path))
+	svg.println()
+}
+
+// AnimateTranslate animates a translate transform on the element
+// referenced by link from from to to over dur milliseconds, repeating
+// repeat times (0 means indefinitely).
+func (svg *SVG) AnimateTranslate(link string, from Point, to Point, dur int, repeat int) {
+	svg.animateTransform(link, "translate", svg.xy(from), svg.xy(to), dur, repeat)
+}
+
+// AnimateRotate animates a rotate transform on the element referenced
+// by link from from.X to to.X degrees over dur milliseconds, repeating
+// repeat times (0 means indefinitely).
+func (svg *SVG) AnimateRotate(link string, from Point, to Point, dur int, repeat int) {
+	svg.animateTransform(link, "rotate", svg.deg(from), svg.deg(to), dur, repeat)
+}
+
+// AnimateScale animates a scale transform on the element referenced
+// by link from from to to over dur milliseconds, repeating repeat
+// times (0 means indefinitely).
+func (svg *SVG) AnimateScale(link string, from Point, to Point, dur int, repeat int) {
+	svg.animateTransform(link, "scale", svg.xy(from), svg.xy(to), dur, repeat)
+}
+
+// AnimateSkewX animates a skewX transform on the element referenced
+// by link from from.X to to.X degrees over dur milliseconds, repeating
+// repeat times (0 means indefinitely).
+func (svg *SVG) AnimateSkewX(link string, from Point, to Point, dur int, repeat int) {
+	svg.animateTransform(link, "skewX", svg.deg(from), svg.deg(to), dur, repeat)
+}
+
+// AnimateSkewY animates a skewY transform on the element referenced
+// by link from from.X to to.X degrees over dur milliseconds, repeating
+// repeat times (0 means indefinitely).
+func (svg *SVG) AnimateSkewY(link string, from Point, to Point, dur int, repeat int) {
+	svg.animateTransform(link, "skewY", svg.deg(from), svg.deg(to), dur, repeat)
+}
+
+// animateTransform emits an <animateTransform> of the given kind
+// ("translate", "rotate", "scale", "skewX", or "skewY").
+// Standard Reference: http://www.w3.org/TR/SVG11/animate.html#AnimateTransformElement
+func (svg *SVG) animateTransform(link string, kind string, from string, to string, dur int, repeat int) {
+	svg.printf(`<animateTransform %s attributeName="transform" type="%s" from="%s" to="%s" dur="%dms" repeatCount="%s"/>`,
+		svg.href(link), kind, from, to, dur, svg.repeatcount(repeat))
+	svg.println()
+}
+
+func (svg *SVG) xy(p Point) string  { return fmt.Sprintf("%g,%g", p.X, p.Y) }
+func (svg *SVG) deg(p Point) string { return fmt.Sprintf("%g", p.X) }
+
+func (svg *SVG) repeatcount(repeat int) string {
+	if repeat <= 0 {
+		return "indefinite"
+	}
+	return fmt.Sprintf("%d", repeat)
+}
+
+// Transforms
+
+// Translate begins a group with a translate transform by x,y.
+// Must be paired with Gend.
+func (svg *SVG) Translate(x int, y int) {
+	svg.println(svg.group("transform", fmt.Sprintf("translate(%d,%d)", x, y)))
+}
+
+// Rotate begins a group with a rotate transform of deg degrees.
+// Must be paired with Gend.
+func (svg *SVG) Rotate(deg float) {
+	svg.println(svg.group("transform", fmt.Sprintf("rotate(%g)", deg)))
+}
+
+// Scale begins a group with a uniform scale transform of k.
+// Must be paired with Gend.
+func (svg *SVG) Scale(k float) {
+	svg.println(svg.group("transform", fmt.Sprintf("scale(%g)", k)))
+}
+
+// ScaleXY begins a group with a non-uniform scale transform of sx, sy.
+// Must be paired with Gend.
+func (svg *SVG) ScaleXY(sx float, sy float) {
+	svg.println(svg.group("transform", fmt.Sprintf("scale(%g,%g)", sx, sy)))
+}
+
+// SkewX begins a group with a skewX transform of a degrees.
+// Must be paired with Gend.
+func (svg *SVG) SkewX(a float) {
+	svg.println(svg.group("transform", fmt.Sprintf("skewX(%g)", a)))
+}
+
+// SkewY begins a group with a skewY transform of a degrees.
+// Must be paired with Gend.
+func (svg *SVG) SkewY(a float) {
+	svg.println(svg.group("transform", fmt.Sprintf("skewY(%g)", a)))
+}
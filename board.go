@@ -0,0 +1,196 @@
+package svg
+
+import "fmt"
+
+// Chessboard rendering: an 8x8 grid of alternating squares, with
+// pieces placed from a FEN placement string. Pieces are drawn from a
+// bundled set of twelve symbols (one per piece type/color, each a
+// small vector glyph built from the core shape API, on a 45x45
+// viewBox) defined once in a single <defs> block and placed with
+// Use, following the Symbol/Use pattern used elsewhere in this
+// package. Rendering pieces as paths/shapes rather than text keeps
+// the output self-contained: it does not depend on the viewer having
+// a font that covers the Unicode chess symbols.
+
+// boardOptions controls the appearance of a Chessboard. Chessboard
+// starts from a conventional board: tan/brown squares, no labels, no
+// highlight, white at the bottom. It is configured by Option functions
+// passed to Chessboard.
+type boardOptions struct {
+	light     Color  // light square color, default "#f0d9b5"
+	dark      Color  // dark square color, default "#b58863"
+	labels    bool   // draw file/rank coordinate labels
+	flipped   bool   // draw from black's perspective
+	lastMove  string // two squares, e.g. "e2e4", to highlight, or ""
+	highlight Color  // highlight color, default "#cdd26a"
+}
+
+// Option configures a Chessboard. See WithLight, WithDark, WithLabels,
+// WithFlipped, WithLastMove, and WithHighlight.
+type Option func(*boardOptions)
+
+// WithLight sets the light square color.
+func WithLight(c Color) Option { return func(o *boardOptions) { o.light = c } }
+
+// WithDark sets the dark square color.
+func WithDark(c Color) Option { return func(o *boardOptions) { o.dark = c } }
+
+// WithLabels turns on file/rank coordinate labels.
+func WithLabels(on bool) Option { return func(o *boardOptions) { o.labels = on } }
+
+// WithFlipped draws the board from black's perspective.
+func WithFlipped(on bool) Option { return func(o *boardOptions) { o.flipped = on } }
+
+// WithLastMove highlights the two squares of a move, e.g. "e2e4".
+func WithLastMove(squares string) Option { return func(o *boardOptions) { o.lastMove = squares } }
+
+// WithHighlight sets the last-move highlight color.
+func WithHighlight(c Color) Option { return func(o *boardOptions) { o.highlight = c } }
+
+var boardPieceOrder = []byte{'K', 'Q', 'R', 'B', 'N', 'P', 'k', 'q', 'r', 'b', 'n', 'p'}
+
+// Chessboard draws an 8x8 board at x,y (upper left-hand corner), with
+// squares of side squareSize, populated from the placement portion of
+// a FEN string (e.g. "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR").
+// Appearance is configured by passing Option values such as WithLight
+// or WithFlipped.
+func (svg *SVG) Chessboard(x int, y int, squareSize int, fen string, opts ...Option) {
+	o := &boardOptions{light: "#f0d9b5", dark: "#b58863", highlight: "#cdd26a"}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	svg.Def()
+	for _, p := range boardPieceOrder {
+		svg.Symbol(boardPieceID(p), `viewBox="0 0 45 45"`)
+		boardDrawPiece(svg, p)
+		svg.SymbolEnd()
+	}
+	svg.DefEnd()
+
+	lastFrom, lastTo := boardLastMove(o.lastMove)
+	placement := boardFENBoard(fen)
+
+	svg.Gid("board")
+	for rank := 0; rank < 8; rank++ {
+		for file := 0; file < 8; file++ {
+			sx, sy := boardSquareXY(x, y, squareSize, file, rank, o.flipped)
+			color := o.light
+			if (file+rank)%2 != 0 {
+				color = o.dark
+			}
+			fs := boardFileRank(file, rank)
+			if fs == lastFrom || fs == lastTo {
+				color = o.highlight
+			}
+			svg.Rect(sx, sy, squareSize, squareSize, fmt.Sprintf("fill:%s", color))
+			if o.labels {
+				boardDrawLabel(svg, sx, sy, squareSize, file, rank, o.flipped)
+			}
+			piece := placement[rank][file]
+			if piece != 0 {
+				svg.Use(sx, sy, "#"+boardPieceID(piece), fmt.Sprintf(`width="%d" height="%d"`, squareSize, squareSize))
+			}
+		}
+	}
+	svg.Gend()
+}
+
+func boardPieceID(p byte) string { return "piece-" + string(p) }
+
+// boardDrawPiece draws a simplified vector glyph for piece (one of
+// K,Q,R,B,N,P or their lowercase black counterparts) on the 45x45
+// coordinate grid established by its enclosing Symbol's viewBox.
+func boardDrawPiece(svg *SVG, piece byte) {
+	fill := Color("#000000")
+	if piece >= 'A' && piece <= 'Z' {
+		fill = "#ffffff"
+	}
+	style := fmt.Sprintf("fill:%s;stroke:#000000;stroke-width:1.5;stroke-linejoin:round", fill)
+
+	switch piece {
+	case 'P', 'p':
+		svg.Circle(22, 14, 7, style)
+		svg.Polygon([]int{13, 32, 28, 17}, []int{38, 38, 24, 24}, style)
+	case 'R', 'r':
+		svg.Polygon([]int{10, 10, 14, 14, 18, 18, 22, 22, 26, 26, 30, 30, 34, 34},
+			[]int{10, 16, 16, 12, 12, 16, 16, 12, 12, 16, 16, 12, 12, 10}, style)
+		svg.Rect(12, 16, 21, 16, style)
+		svg.Polygon([]int{9, 36, 32, 13}, []int{38, 38, 32, 32}, style)
+	case 'N', 'n':
+		svg.Polygon([]int{12, 12, 18, 30, 34, 34, 28, 16},
+			[]int{38, 24, 10, 10, 18, 26, 26, 38}, style)
+		svg.Polygon([]int{9, 36, 32, 13}, []int{38, 38, 32, 32}, style)
+	case 'B', 'b':
+		svg.Circle(22, 8, 3, style)
+		svg.Polygon([]int{22, 31, 28, 16, 13}, []int{12, 26, 38, 38, 26}, style)
+		svg.Polygon([]int{9, 36, 32, 13}, []int{38, 38, 32, 32}, style)
+	case 'Q', 'q':
+		svg.Polygon([]int{9, 14, 18, 22, 26, 30, 35},
+			[]int{30, 10, 24, 8, 24, 10, 30}, style)
+		svg.Rect(11, 30, 23, 8, style)
+	case 'K', 'k':
+		svg.Line(22, 4, 22, 14, style)
+		svg.Line(17, 9, 27, 9, style)
+		svg.Polygon([]int{12, 32, 28, 16}, []int{34, 34, 14, 14}, style)
+		svg.Polygon([]int{9, 36, 32, 13}, []int{38, 38, 32, 32}, style)
+	}
+}
+
+// boardFENBoard parses the placement field of a FEN string into an 8x8
+// array, board[rank][file], rank 0 being the FEN's first (8th) rank.
+func boardFENBoard(fen string) [8][8]byte {
+	var board [8][8]byte
+	placement := fen
+	for i := 0; i < len(fen); i++ {
+		if fen[i] == ' ' {
+			placement = fen[:i]
+			break
+		}
+	}
+	rank, file := 0, 0
+	for i := 0; i < len(placement); i++ {
+		c := placement[i]
+		switch {
+		case c == '/':
+			rank++
+			file = 0
+		case c >= '1' && c <= '8':
+			file += int(c - '0')
+		default:
+			if rank < 8 && file < 8 {
+				board[rank][file] = c
+			}
+			file++
+		}
+	}
+	return board
+}
+
+func boardSquareXY(x int, y int, squareSize int, file int, rank int, flipped bool) (int, int) {
+	f, r := file, rank
+	if flipped {
+		f, r = 7-file, 7-rank
+	}
+	return x + f*squareSize, y + r*squareSize
+}
+
+func boardFileRank(file int, rank int) string {
+	return fmt.Sprintf("%c%d", 'a'+file, 8-rank)
+}
+
+func boardLastMove(lastMove string) (string, string) {
+	if len(lastMove) != 4 {
+		return "", ""
+	}
+	return lastMove[0:2], lastMove[2:4]
+}
+
+func boardDrawLabel(svg *SVG, sx int, sy int, squareSize int, file int, rank int, flipped bool) {
+	if rank == 7 || (flipped && rank == 0) {
+		svg.Text(sx+2, sy+squareSize-2, string('a'+byte(file)), fmt.Sprintf("font-size:%dpx", squareSize/5))
+	}
+	if file == 0 || (flipped && file == 7) {
+		svg.Text(sx+2, sy+squareSize/4, fmt.Sprintf("%d", 8-rank), fmt.Sprintf("font-size:%dpx", squareSize/5))
+	}
+}
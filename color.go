@@ -0,0 +1,98 @@
+package svg
+
+import "fmt"
+
+// Color is a CSS/SVG paint value such as "#ff0000", "steelblue", or
+// "rgba(0,0,0,0.5)". It is used anywhere a fill, stroke, or gradient
+// stop needs a color, and is accepted directly as an SVG attribute value.
+type Color string
+
+// Offcolor describes one stop of a gradient: where it falls along the
+// gradient vector (Offset, 0 to 1), what color it is, and its opacity.
+type Offcolor struct {
+	Offset  float
+	Color   Color
+	Opacity float
+}
+
+// NamedColor returns the SVG/CSS3 named color matching name (one of
+// the ~147 keywords defined by http://www.w3.org/TR/css3-color/#svg-color),
+// or black if name is not recognized.
+func NamedColor(name string) Color {
+	if hex, ok := svgNamedColors[name]; ok {
+		return Color(hex)
+	}
+	return Color("#000000")
+}
+
+// Hex returns the color specified by the CSS hex string s (e.g.
+// "#ff0000" or "ff0000"), adding the leading "#" if it is missing.
+func Hex(s string) Color {
+	if len(s) > 0 && s[0] != '#' {
+		return Color("#" + s)
+	}
+	return Color(s)
+}
+
+// HSL returns the color specified by hue h (0-360), saturation s
+// (0-1), and lightness l (0-1).
+// Standard Reference: http://www.w3.org/TR/css3-color/#hsl-color
+func HSL(h float, s float, l float) Color {
+	r, g, b := hslToRGB(h, s, l)
+	return Color(fmt.Sprintf("rgb(%d,%d,%d)", r, g, b))
+}
+
+// HSLA returns the color specified by hue h (0-360), saturation s
+// (0-1), lightness l (0-1), and opacity a (0-1).
+func HSLA(h float, s float, l float, a float) Color {
+	r, g, b := hslToRGB(h, s, l)
+	return Color(fmt.Sprintf("rgba(%d,%d,%d,%.2f)", r, g, b, a))
+}
+
+// hslToRGB converts hue h (0-360), saturation s (0-1), and lightness
+// l (0-1) to 8-bit red, green, and blue components.
+func hslToRGB(h float, s float, l float) (int, int, int) {
+	if s == 0 {
+		v := int(l * 255)
+		return v, v, v
+	}
+	var q float
+	if l < 0.5 {
+		q = l * (1 + s)
+	} else {
+		q = l + s - l*s
+	}
+	p := 2*l - q
+	hk := h / 360
+	r := hueToRGB(p, q, hk+1.0/3)
+	g := hueToRGB(p, q, hk)
+	b := hueToRGB(p, q, hk-1.0/3)
+	return int(r * 255), int(g * 255), int(b * 255)
+}
+
+func hueToRGB(p float, q float, t float) float {
+	if t < 0 {
+		t += 1
+	}
+	if t > 1 {
+		t -= 1
+	}
+	switch {
+	case t < 1.0/6:
+		return p + (q-p)*6*t
+	case t < 1.0/2:
+		return q
+	case t < 2.0/3:
+		return p + (q-p)*(2.0/3-t)*6
+	}
+	return p
+}
+
+// Fill returns a style fragment that fills with color c.
+func (svg *SVG) Fill(c Color) string { return fmt.Sprintf("fill:%s", c) }
+
+// Stroke returns a style fragment that strokes with color c at the
+// specified width.
+func (svg *SVG) Stroke(c Color, width float) string {
+	return fmt.Sprintf("stroke:%s;stroke-width:%g", c, width)
+}
@@ -0,0 +1,164 @@
+package svg
+
+// Filter effects: a <filter> element containing one or more
+// filter primitives (fe*), referenced elsewhere with filter="url(#id)".
+
+// Filter begins a filter effect definition with the specified id.
+// Filter primitives are added between Filter and FilterEnd.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#FilterElement
+func (svg *SVG) Filter(id string, s ...string) {
+	svg.printf(`<filter id="%s" %s>`, id, svg.style(optstyle(s)))
+	svg.println()
+}
+
+// FilterEnd ends a filter effect definition.
+func (svg *SVG) FilterEnd() { svg.println(`</filter>`) }
+
+// FeGaussianBlur blurs the in input by stdDeviation, producing result.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feGaussianBlurElement
+func (svg *SVG) FeGaussianBlur(in string, stdDev string, result string) {
+	svg.printf(`<feGaussianBlur in="%s" stdDeviation="%s" result="%s"/>`, in, stdDev, result)
+	svg.println()
+}
+
+// FeColorMatrix applies a color matrix of the given kind ("matrix",
+// "saturate", "hueRotate", or "luminanceToAlpha") with the specified
+// values to the in input, producing result.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feColorMatrixElement
+func (svg *SVG) FeColorMatrix(in string, kind string, values string, result string) {
+	svg.printf(`<feColorMatrix in="%s" type="%s" values="%s" result="%s"/>`, in, kind, values, result)
+	svg.println()
+}
+
+// FeBlend blends the in and in2 inputs using the specified mode
+// ("normal", "multiply", "screen", "darken", "lighten"), producing result.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feBlendElement
+func (svg *SVG) FeBlend(in string, in2 string, mode string, result string) {
+	svg.printf(`<feBlend in="%s" in2="%s" mode="%s" result="%s"/>`, in, in2, mode, result)
+	svg.println()
+}
+
+// FeComposite combines the in and in2 inputs with the Porter-Duff
+// operator, with the arithmetic coefficients k1-k4 used when
+// operator is "arithmetic", producing result.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feCompositeElement
+func (svg *SVG) FeComposite(in string, in2 string, operator string, k1 float, k2 float, k3 float, k4 float, result string) {
+	svg.printf(`<feComposite in="%s" in2="%s" operator="%s" k1="%g" k2="%g" k3="%g" k4="%g" result="%s"/>`,
+		in, in2, operator, k1, k2, k3, k4, result)
+	svg.println()
+}
+
+// FeOffset shifts the in input by dx,dy, producing result.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feOffsetElement
+func (svg *SVG) FeOffset(in string, dx float, dy float, result string) {
+	svg.printf(`<feOffset in="%s" dx="%g" dy="%g" result="%s"/>`, in, dx, dy, result)
+	svg.println()
+}
+
+// FeMerge composites the named filter results (or inputs), in order,
+// on top of each other, producing a single merged result.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feMergeElement
+func (svg *SVG) FeMerge(nodes []string) {
+	svg.println(`<feMerge>`)
+	for _, n := range nodes {
+		svg.printf(`<feMergeNode in="%s"/>`, n)
+		svg.println()
+	}
+	svg.println(`</feMerge>`)
+}
+
+// FeTurbulence generates a Perlin noise image with the specified
+// baseFrequency and numOctaves, seeded by seed, of kind "turbulence"
+// or "fractalNoise", producing result.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feTurbulenceElement
+func (svg *SVG) FeTurbulence(baseFrequency string, numOctaves int, seed int, kind string, result string) {
+	svg.printf(`<feTurbulence baseFrequency="%s" numOctaves="%d" seed="%d" type="%s" result="%s"/>`,
+		baseFrequency, numOctaves, seed, kind, result)
+	svg.println()
+}
+
+// FeDisplacementMap displaces the in input using the color channels
+// xChannelSelector and yChannelSelector of the in2 input, scaled by
+// scale, producing result.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feDisplacementMapElement
+func (svg *SVG) FeDisplacementMap(in string, in2 string, scale float, xChannelSelector string, yChannelSelector string, result string) {
+	svg.printf(`<feDisplacementMap in="%s" in2="%s" scale="%g" xChannelSelector="%s" yChannelSelector="%s" result="%s"/>`,
+		in, in2, scale, xChannelSelector, yChannelSelector, result)
+	svg.println()
+}
+
+// FeConvolveMatrix convolves the in input with the given order
+// (columns x rows) and kernelMatrix, producing result. Additional
+// attributes (divisor, bias, edgeMode, preserveAlpha, ...) may be
+// given as name="value" pairs in s.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feConvolveMatrixElement
+func (svg *SVG) FeConvolveMatrix(in string, order string, kernelMatrix string, result string, s ...string) {
+	svg.printf(`<feConvolveMatrix in="%s" order="%s" kernelMatrix="%s" result="%s" %s/>`,
+		in, order, kernelMatrix, result, svg.nvlist(s))
+	svg.println()
+}
+
+// FeDiffuseLighting begins a diffuse lighting filter primitive with
+// the given surfaceScale and diffuseConstant, producing result.
+// A light source (FeDistantLight, FePointLight, or FeSpotLight) must
+// follow, closed with FeDiffuseLightingEnd.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feDiffuseLightingElement
+func (svg *SVG) FeDiffuseLighting(in string, surfaceScale float, diffuseConstant float, result string, s ...string) {
+	svg.printf(`<feDiffuseLighting in="%s" surfaceScale="%g" diffuseConstant="%g" result="%s" %s>`,
+		in, surfaceScale, diffuseConstant, result, svg.nvlist(s))
+	svg.println()
+}
+
+// FeDiffuseLightingEnd ends a diffuse lighting filter primitive.
+func (svg *SVG) FeDiffuseLightingEnd() { svg.println(`</feDiffuseLighting>`) }
+
+// FeSpecularLighting begins a specular lighting filter primitive with
+// the given surfaceScale, specularConstant, and specularExponent,
+// producing result. A light source must follow, closed with
+// FeSpecularLightingEnd.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feSpecularLightingElement
+func (svg *SVG) FeSpecularLighting(in string, surfaceScale float, specularConstant float, specularExponent float, result string, s ...string) {
+	svg.printf(`<feSpecularLighting in="%s" surfaceScale="%g" specularConstant="%g" specularExponent="%g" result="%s" %s>`,
+		in, surfaceScale, specularConstant, specularExponent, result, svg.nvlist(s))
+	svg.println()
+}
+
+// FeSpecularLightingEnd ends a specular lighting filter primitive.
+func (svg *SVG) FeSpecularLightingEnd() { svg.println(`</feSpecularLighting>`) }
+
+// FeDistantLight defines a distant light source, at the given
+// azimuth and elevation, for use inside a lighting filter primitive.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feDistantLightElement
+func (svg *SVG) FeDistantLight(azimuth float, elevation float) {
+	svg.printf(`<feDistantLight azimuth="%g" elevation="%g"/>`, azimuth, elevation)
+	svg.println()
+}
+
+// FePointLight defines a point light source, at x,y,z, for use
+// inside a lighting filter primitive.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#fePointLightElement
+func (svg *SVG) FePointLight(x float, y float, z float) {
+	svg.printf(`<fePointLight x="%g" y="%g" z="%g"/>`, x, y, z)
+	svg.println()
+}
+
+// FeSpotLight defines a spotlight source, at x,y,z pointing at
+// pointsAtX,pointsAtY,pointsAtZ, for use inside a lighting filter
+// primitive. Additional attributes (specularExponent, limitingConeAngle)
+// may be given as name="value" pairs in s.
+// Standard Reference: http://www.w3.org/TR/SVG11/filters.html#feSpotLightElement
+func (svg *SVG) FeSpotLight(x float, y float, z float, pointsAtX float, pointsAtY float, pointsAtZ float, s ...string) {
+	svg.printf(`<feSpotLight x="%g" y="%g" z="%g" pointsAtX="%g" pointsAtY="%g" pointsAtZ="%g" %s/>`,
+		x, y, z, pointsAtX, pointsAtY, pointsAtZ, svg.nvlist(s))
+	svg.println()
+}
+
+// nvlist joins a list of name="value" attribute strings with spaces,
+// for filter primitives that take an open-ended set of attributes.
+func (svg *SVG) nvlist(s []string) string {
+	nv := ""
+	for _, v := range s {
+		nv += v + " "
+	}
+	return nv
+}
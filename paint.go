@@ -0,0 +1,83 @@
+package svg
+
+// Paint servers: gradients, patterns, masks, and markers.
+// These are defined inside a Def/DefEnd block and referenced elsewhere
+// with fill="url(#id)" or style="mask:url(#id)".
+
+// LinearGradient constructs a linear gradient with the specified id,
+// beginning at x1,y1 and ending at x2,y2, composed of the given stops.
+// Standard Reference: http://www.w3.org/TR/SVG11/pservers.html#LinearGradientElement
+func (svg *SVG) LinearGradient(id string, x1 int, y1 int, x2 int, y2 int, stops []Offcolor) {
+	svg.printf(`<linearGradient id="%s" x1="%d%%" y1="%d%%" x2="%d%%" y2="%d%%">`,
+		id, x1, y1, x2, y2)
+	svg.println()
+	svg.offcolor(stops)
+	svg.println(`</linearGradient>`)
+}
+
+// RadialGradient constructs a radial gradient with the specified id,
+// centered at cx,cy with radius r, with the focal point at fx,fy,
+// composed of the given stops.
+// Standard Reference: http://www.w3.org/TR/SVG11/pservers.html#RadialGradientElement
+func (svg *SVG) RadialGradient(id string, cx int, cy int, r int, fx int, fy int, stops []Offcolor) {
+	svg.printf(`<radialGradient id="%s" cx="%d%%" cy="%d%%" r="%d%%" fx="%d%%" fy="%d%%">`,
+		id, cx, cy, r, fx, fy)
+	svg.println()
+	svg.offcolor(stops)
+	svg.println(`</radialGradient>`)
+}
+
+// offcolor emits the <stop/> elements for a slice of gradient stops.
+func (svg *SVG) offcolor(stops []Offcolor) {
+	for _, off := range stops {
+		svg.printf(`<stop offset="%.2f%%" stop-color="%s" stop-opacity="%.2f"/>`,
+			off.Offset*100, off.Color, off.Opacity)
+		svg.println()
+	}
+}
+
+// Pattern begins a pattern definition with the specified id, tiled at
+// x,y with width w and height h, with optional style. Pattern content
+// (shapes, images) is added between Pattern and PatternEnd.
+// Standard Reference: http://www.w3.org/TR/SVG11/pservers.html#PatternElement
+func (svg *SVG) Pattern(id string, x int, y int, w int, h int, s ...string) {
+	svg.printf(`<pattern id="%s" %s patternUnits="userSpaceOnUse" %s>`,
+		id, svg.dim(x, y, w, h), svg.style(optstyle(s)))
+	svg.println()
+}
+
+// PatternEnd ends a pattern definition.
+func (svg *SVG) PatternEnd() { svg.println(`</pattern>`) }
+
+// Mask begins a mask definition with the specified id, covering the
+// region x,y,w,h. Mask content is added between Mask and MaskEnd.
+// Standard Reference: http://www.w3.org/TR/SVG11/masking.html#Mask
+func (svg *SVG) Mask(id string, x int, y int, w int, h int, s ...string) {
+	svg.printf(`<mask id="%s" %s %s>`, id, svg.dim(x, y, w, h), svg.style(optstyle(s)))
+	svg.println()
+}
+
+// MaskEnd ends a mask definition.
+func (svg *SVG) MaskEnd() { svg.println(`</mask>`) }
+
+// Marker begins a marker definition with the specified id, reference
+// point refX,refY, and viewport width, height. Marker content
+// (typically a small path or shape) is added between Marker and MarkerEnd.
+// Standard Reference: http://www.w3.org/TR/SVG11/painting.html#MarkerElement
+func (svg *SVG) Marker(id string, refX int, refY int, width int, height int, s ...string) {
+	svg.printf(`<marker id="%s" refX="%d" refY="%d" markerWidth="%d" markerHeight="%d" %s>`,
+		id, refX, refY, width, height, svg.style(optstyle(s)))
+	svg.println()
+}
+
+// MarkerEnd ends a marker definition.
+func (svg *SVG) MarkerEnd() { svg.println(`</marker>`) }
+
+// optstyle returns the first element of a variadic style list, or the
+// empty string if none was given; any further elements are ignored.
+func optstyle(s []string) string {
+	if len(s) > 0 {
+		return s[0]
+	}
+	return ""
+}
@@ -0,0 +1,189 @@
+package svg
+
+import "fmt"
+
+// Path is a builder for the "d" attribute of a single <path> element,
+// allowing a moveto to be followed by any number of curves, lines,
+// and arcs before being flushed with End. Construct one with SVG.Path.
+// Standard Reference: http://www.w3.org/TR/SVG11/paths.html#PathData
+type Path struct {
+	svg   *SVG
+	d     string
+	style string
+}
+
+// Path begins a path builder, with optional style.
+func (svg *SVG) Path(s ...string) *Path {
+	return &Path{svg: svg, style: optstyle(s)}
+}
+
+// End flushes the accumulated path data as a single <path> element.
+func (p *Path) End() {
+	p.svg.printf(`<path d="%s" %s`, p.d, p.svg.endstyle([]string{p.style}))
+}
+
+func (p *Path) op(letter string, coords ...float) *Path {
+	p.d += letter
+	for i, c := range coords {
+		if i > 0 {
+			p.d += ","
+		}
+		p.d += fmt.Sprintf("%g", c)
+	}
+	p.d += " "
+	return p
+}
+
+// MoveTo begins a new subpath at the absolute coordinate x,y.
+func (p *Path) MoveTo(x float, y float) *Path { return p.op("M", x, y) }
+
+// M is a synonym for MoveTo.
+func (p *Path) M(x float, y float) *Path { return p.MoveTo(x, y) }
+
+// MoveToRel begins a new subpath at x,y, relative to the current
+// point ("m" in SVG path-data grammar).
+func (p *Path) MoveToRel(x float, y float) *Path { return p.op("m", x, y) }
+
+// Mrel is a synonym for MoveToRel.
+func (p *Path) Mrel(x float, y float) *Path { return p.MoveToRel(x, y) }
+
+// LineTo draws a line to the absolute coordinate x,y.
+func (p *Path) LineTo(x float, y float) *Path { return p.op("L", x, y) }
+
+// L is a synonym for LineTo.
+func (p *Path) L(x float, y float) *Path { return p.LineTo(x, y) }
+
+// LineToRel draws a line to x,y, relative to the current point
+// ("l" in SVG path-data grammar).
+func (p *Path) LineToRel(x float, y float) *Path { return p.op("l", x, y) }
+
+// Lrel is a synonym for LineToRel.
+func (p *Path) Lrel(x float, y float) *Path { return p.LineToRel(x, y) }
+
+// HLine draws a horizontal line to the absolute coordinate x.
+func (p *Path) HLine(x float) *Path { return p.op("H", x) }
+
+// H is a synonym for HLine.
+func (p *Path) H(x float) *Path { return p.HLine(x) }
+
+// HLineRel draws a horizontal line to x, relative to the current
+// point ("h" in SVG path-data grammar).
+func (p *Path) HLineRel(x float) *Path { return p.op("h", x) }
+
+// Hrel is a synonym for HLineRel.
+func (p *Path) Hrel(x float) *Path { return p.HLineRel(x) }
+
+// VLine draws a vertical line to the absolute coordinate y.
+func (p *Path) VLine(y float) *Path { return p.op("V", y) }
+
+// V is a synonym for VLine.
+func (p *Path) V(y float) *Path { return p.VLine(y) }
+
+// VLineRel draws a vertical line to y, relative to the current point
+// ("v" in SVG path-data grammar).
+func (p *Path) VLineRel(y float) *Path { return p.op("v", y) }
+
+// Vrel is a synonym for VLineRel.
+func (p *Path) Vrel(y float) *Path { return p.VLineRel(y) }
+
+// CurveTo draws a cubic Bezier curve to x,y with control points x1,y1 and x2,y2.
+func (p *Path) CurveTo(x1 float, y1 float, x2 float, y2 float, x float, y float) *Path {
+	return p.op("C", x1, y1, x2, y2, x, y)
+}
+
+// C is a synonym for CurveTo.
+func (p *Path) C(x1 float, y1 float, x2 float, y2 float, x float, y float) *Path {
+	return p.CurveTo(x1, y1, x2, y2, x, y)
+}
+
+// CurveToRel draws a cubic Bezier curve, relative to the current
+// point ("c" in SVG path-data grammar).
+func (p *Path) CurveToRel(x1 float, y1 float, x2 float, y2 float, x float, y float) *Path {
+	return p.op("c", x1, y1, x2, y2, x, y)
+}
+
+// Crel is a synonym for CurveToRel.
+func (p *Path) Crel(x1 float, y1 float, x2 float, y2 float, x float, y float) *Path {
+	return p.CurveToRel(x1, y1, x2, y2, x, y)
+}
+
+// SmoothCurveTo draws a cubic Bezier curve to x,y with control point
+// x2,y2, reflecting the previous curve's control point for the first.
+func (p *Path) SmoothCurveTo(x2 float, y2 float, x float, y float) *Path {
+	return p.op("S", x2, y2, x, y)
+}
+
+// S is a synonym for SmoothCurveTo.
+func (p *Path) S(x2 float, y2 float, x float, y float) *Path { return p.SmoothCurveTo(x2, y2, x, y) }
+
+// SmoothCurveToRel draws a smooth cubic Bezier curve, relative to the
+// current point ("s" in SVG path-data grammar).
+func (p *Path) SmoothCurveToRel(x2 float, y2 float, x float, y float) *Path {
+	return p.op("s", x2, y2, x, y)
+}
+
+// Srel is a synonym for SmoothCurveToRel.
+func (p *Path) Srel(x2 float, y2 float, x float, y float) *Path {
+	return p.SmoothCurveToRel(x2, y2, x, y)
+}
+
+// QuadTo draws a quadratic Bezier curve to x,y with control point x1,y1.
+func (p *Path) QuadTo(x1 float, y1 float, x float, y float) *Path { return p.op("Q", x1, y1, x, y) }
+
+// Q is a synonym for QuadTo.
+func (p *Path) Q(x1 float, y1 float, x float, y float) *Path { return p.QuadTo(x1, y1, x, y) }
+
+// QuadToRel draws a quadratic Bezier curve, relative to the current
+// point ("q" in SVG path-data grammar).
+func (p *Path) QuadToRel(x1 float, y1 float, x float, y float) *Path {
+	return p.op("q", x1, y1, x, y)
+}
+
+// Qrel is a synonym for QuadToRel.
+func (p *Path) Qrel(x1 float, y1 float, x float, y float) *Path { return p.QuadToRel(x1, y1, x, y) }
+
+// SmoothQuadTo draws a smooth quadratic Bezier curve to x,y, reflecting
+// the previous curve's control point.
+func (p *Path) SmoothQuadTo(x float, y float) *Path { return p.op("T", x, y) }
+
+// T is a synonym for SmoothQuadTo.
+func (p *Path) T(x float, y float) *Path { return p.SmoothQuadTo(x, y) }
+
+// SmoothQuadToRel draws a smooth quadratic Bezier curve, relative to
+// the current point ("t" in SVG path-data grammar).
+func (p *Path) SmoothQuadToRel(x float, y float) *Path { return p.op("t", x, y) }
+
+// Trel is a synonym for SmoothQuadToRel.
+func (p *Path) Trel(x float, y float) *Path { return p.SmoothQuadToRel(x, y) }
+
+// ArcTo draws an elliptical arc of radii rx,ry, x-axis rotation xrot,
+// to the absolute coordinate x,y. If large is true the arc sweep angle
+// is greater than or equal to 180 degrees; if sweep is true the arc is
+// drawn in the positive-angle (clockwise) direction.
+func (p *Path) ArcTo(rx float, ry float, xrot float, large bool, sweep bool, x float, y float) *Path {
+	p.d += fmt.Sprintf("A%g,%g %g %s,%s %g,%g ", rx, ry, xrot, p.svg.onezero(large), p.svg.onezero(sweep), x, y)
+	return p
+}
+
+// A is a synonym for ArcTo.
+func (p *Path) A(rx float, ry float, xrot float, large bool, sweep bool, x float, y float) *Path {
+	return p.ArcTo(rx, ry, xrot, large, sweep, x, y)
+}
+
+// ArcToRel draws an elliptical arc, relative to the current point
+// ("a" in SVG path-data grammar).
+func (p *Path) ArcToRel(rx float, ry float, xrot float, large bool, sweep bool, x float, y float) *Path {
+	p.d += fmt.Sprintf("a%g,%g %g %s,%s %g,%g ", rx, ry, xrot, p.svg.onezero(large), p.svg.onezero(sweep), x, y)
+	return p
+}
+
+// Arel is a synonym for ArcToRel.
+func (p *Path) Arel(rx float, ry float, xrot float, large bool, sweep bool, x float, y float) *Path {
+	return p.ArcToRel(rx, ry, xrot, large, sweep, x, y)
+}
+
+// Close closes the current subpath by drawing a line back to its start.
+func (p *Path) Close() *Path { p.d += "Z "; return p }
+
+// Z is a synonym for Close.
+func (p *Path) Z() *Path { return p.Close() }
@@ -0,0 +1,171 @@
+package svg
+
+import "fmt"
+
+// QR code generation: a small pure-Go byte-mode encoder, rendered as a
+// single compact <path> (one row of contiguous dark-module segments)
+// rather than one <rect> per module. Supports error correction levels
+// "L" and "M" at the versions small enough to fit in a single Reed-
+// Solomon block (versions 1-5 at L, 1-3 at M); anything larger than
+// that capacity is silently not rendered, matching the rest of this
+// package's handling of malformed input (see pp's length check).
+
+// qrblock describes the single-block codeword layout for one
+// (version, level) pair, as specified in ISO/IEC 18004 table 9.
+type qrblock struct {
+	total int // total codewords (data + ec)
+	ec    int // error correction codewords
+	data  int // data codewords
+}
+
+var qrblocks = map[string]qrblock{
+	"1L": {26, 7, 19},
+	"2L": {44, 10, 34},
+	"3L": {70, 15, 55},
+	"4L": {100, 20, 80},
+	"5L": {134, 26, 108},
+	"1M": {26, 10, 16},
+	"2M": {44, 16, 28},
+	"3M": {70, 26, 44},
+}
+
+// alignment center coordinate, by version (only versions 2-5 have one).
+var qralign = map[int]int{2: 18, 3: 22, 4: 26, 5: 30}
+
+var qrformat = map[string]uint{"L": 1, "M": 0, "Q": 3, "H": 2}
+
+// QRCode renders data as a QR code at x,y with the given moduleSize
+// (in user units), using error correction level ecc ("L" or "M") and
+// a quiet zone of quietModules modules, with optional style. The
+// symbol is emitted as a single path inside a group, so styling
+// composes with the rest of the document.
+func (svg *SVG) QRCode(x int, y int, moduleSize int, data string, ecc string, quietModules int, s ...string) {
+	matrix, size := qrEncode(data, ecc)
+	if matrix == nil {
+		return
+	}
+	if len(s) > 0 {
+		svg.Gstyle(s[0])
+	} else {
+		svg.Gid("qrcode")
+	}
+	d := ""
+	for row := 0; row < size; row++ {
+		col := 0
+		for col < size {
+			if !matrix[row][col] {
+				col++
+				continue
+			}
+			start := col
+			for col < size && matrix[row][col] {
+				col++
+			}
+			mx := x + (quietModules+start)*moduleSize
+			my := y + (quietModules+row)*moduleSize + moduleSize/2
+			w := (col - start) * moduleSize
+			d += fmt.Sprintf("M%d,%d h%d ", mx, my, w)
+		}
+	}
+	svg.printf(`<path d="%s" stroke-width="%d" stroke="black" fill="none"`, d, moduleSize)
+	svg.println(`/>`)
+	svg.Gend()
+}
+
+// qrEncode builds the module matrix for data at error correction
+// level ecc, returning nil if the payload does not fit in the
+// supported version range.
+func qrEncode(data string, ecc string) ([][]bool, int) {
+	version, blk := qrFit(data, ecc)
+	if version == 0 {
+		return nil, 0
+	}
+	codewords := qrCodewords(data, blk)
+	ecwords := rsEncode(codewords, blk.ec)
+	final := append(codewords, ecwords...)
+	size := 17 + 4*version
+	matrix := make([][]bool, size)
+	reserved := make([][]bool, size)
+	for i := range matrix {
+		matrix[i] = make([]bool, size)
+		reserved[i] = make([]bool, size)
+	}
+	qrPlaceFinder(matrix, reserved, 0, 0)
+	qrPlaceFinder(matrix, reserved, size-7, 0)
+	qrPlaceFinder(matrix, reserved, 0, size-7)
+	if c, ok := qralign[version]; ok {
+		qrPlaceAlignment(matrix, reserved, c, c)
+	}
+	qrPlaceTiming(matrix, reserved, size)
+	matrix[size-8][8] = true
+	reserved[size-8][8] = true
+	qrReserveFormat(reserved, size)
+	qrPlaceData(matrix, reserved, size, final)
+	qrApplyMask(matrix, reserved, size)
+	qrPlaceFormat(matrix, size, qrformat[ecc])
+	return matrix, size
+}
+
+func qrFit(data string, ecc string) (int, qrblock) {
+	for v := 1; v <= 5; v++ {
+		blk, ok := qrblocks[fmt.Sprintf("%d%s", v, ecc)]
+		if !ok {
+			continue
+		}
+		if len(data)+2 <= blk.data { // mode+count header is 12 bits < 2 bytes
+			return v, blk
+		}
+	}
+	return 0, qrblock{}
+}
+
+// qrCodewords builds the data codeword sequence: mode indicator,
+// character count, data bytes, terminator, and padding.
+func qrCodewords(data string, blk qrblock) []byte {
+	bits := newBitWriter()
+	bits.write(4, 0x4) // byte mode
+	bits.write(8, uint(len(data)))
+	for i := 0; i < len(data); i++ {
+		bits.write(8, uint(data[i]))
+	}
+	capacity := blk.data * 8
+	term := capacity - bits.len()
+	if term > 4 {
+		term = 4
+	}
+	if term > 0 {
+		bits.write(uint(term), 0)
+	}
+	for bits.len()%8 != 0 {
+		bits.write(1, 0)
+	}
+	pad := [2]byte{0xEC, 0x11}
+	for i := 0; bits.len() < capacity; i++ {
+		bits.write(8, uint(pad[i%2]))
+	}
+	return bits.bytes
+}
+
+// bitWriter accumulates bits, most significant bit first, into bytes.
+type bitWriter struct {
+	bytes []byte
+	nbits int
+}
+
+func newBitWriter() *bitWriter { return &bitWriter{} }
+
+func (b *bitWriter) len() int { return b.nbits }
+
+func (b *bitWriter) write(n uint, v uint) {
+	for i := int(n) - 1; i >= 0; i-- {
+		bit := (v >> uint(i)) & 1
+		byteIndex := b.nbits / 8
+		for byteIndex >= len(b.bytes) {
+			b.bytes = append(b.bytes, 0)
+		}
+		if bit == 1 {
+			b.bytes[byteIndex] |= 1 << uint(7-b.nbits%8)
+		}
+		b.nbits++
+	}
+}
@@ -0,0 +1,226 @@
+package svg
+
+// GF(256) arithmetic and module placement for QRCode, following the
+// structure laid out in ISO/IEC 18004 (finder/alignment/timing
+// patterns, zigzag data placement, and the checkerboard mask).
+
+var qrExp [512]byte
+var qrLog [256]byte
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		qrExp[i] = byte(x)
+		qrLog[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11d
+		}
+	}
+	for i := 255; i < 512; i++ {
+		qrExp[i] = qrExp[i-255]
+	}
+}
+
+func gfMul(a byte, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return qrExp[int(qrLog[a])+int(qrLog[b])]
+}
+
+// rsGenerator returns the Reed-Solomon generator polynomial for n
+// error correction codewords, coefficients highest degree first.
+func rsGenerator(n int) []byte {
+	gen := []byte{1}
+	for i := 0; i < n; i++ {
+		next := make([]byte, len(gen)+1)
+		for j, c := range gen {
+			next[j] ^= gfMul(c, 1)
+			next[j+1] ^= gfMul(c, qrExp[i])
+		}
+		gen = next
+	}
+	return gen
+}
+
+// rsEncode computes n Reed-Solomon error correction codewords for data.
+func rsEncode(data []byte, n int) []byte {
+	gen := rsGenerator(n)
+	res := make([]byte, len(data)+n)
+	copy(res, data)
+	for i := 0; i < len(data); i++ {
+		coef := res[i]
+		if coef == 0 {
+			continue
+		}
+		for j, g := range gen {
+			res[i+j] ^= gfMul(g, coef)
+		}
+	}
+	return res[len(data):]
+}
+
+func qrSet(matrix [][]bool, reserved [][]bool, row int, col int, val bool) {
+	matrix[row][col] = val
+	reserved[row][col] = true
+}
+
+// qrPlaceFinder draws a 7x7 finder pattern with its separator, with
+// the upper-left corner of the 7x7 block at row,col.
+func qrPlaceFinder(matrix [][]bool, reserved [][]bool, row int, col int) {
+	size := len(matrix)
+	for r := -1; r <= 7; r++ {
+		for c := -1; c <= 7; c++ {
+			rr, cc := row+r, col+c
+			if rr < 0 || rr >= size || cc < 0 || cc >= size {
+				continue
+			}
+			dark := false
+			if r >= 0 && r <= 6 && c >= 0 && c <= 6 {
+				if r == 0 || r == 6 || c == 0 || c == 6 {
+					dark = true
+				} else if r >= 2 && r <= 4 && c >= 2 && c <= 4 {
+					dark = true
+				}
+			}
+			qrSet(matrix, reserved, rr, cc, dark)
+		}
+	}
+}
+
+// qrPlaceAlignment draws a 5x5 alignment pattern centered at row,col.
+func qrPlaceAlignment(matrix [][]bool, reserved [][]bool, row int, col int) {
+	for r := -2; r <= 2; r++ {
+		for c := -2; c <= 2; c++ {
+			dark := r == -2 || r == 2 || c == -2 || c == 2 || (r == 0 && c == 0)
+			qrSet(matrix, reserved, row+r, col+c, dark)
+		}
+	}
+}
+
+// qrPlaceTiming draws the horizontal and vertical timing patterns on
+// row/column 6, alternating dark/light modules.
+func qrPlaceTiming(matrix [][]bool, reserved [][]bool, size int) {
+	for i := 8; i < size-8; i++ {
+		dark := i%2 == 0
+		if !reserved[6][i] {
+			qrSet(matrix, reserved, 6, i, dark)
+		}
+		if !reserved[i][6] {
+			qrSet(matrix, reserved, i, 6, dark)
+		}
+	}
+}
+
+// qrReserveFormat marks the format information modules as reserved,
+// without giving them a value yet (filled in later by qrPlaceFormat).
+func qrReserveFormat(reserved [][]bool, size int) {
+	for i := 0; i <= 8; i++ {
+		reserved[8][i] = true
+		reserved[i][8] = true
+	}
+	for i := 0; i <= 7; i++ {
+		reserved[size-1-i][8] = true
+		reserved[8][size-1-i] = true
+	}
+}
+
+// qrPlaceData walks the matrix in the standard zigzag order (two
+// columns at a time, bottom to top then top to bottom, skipping the
+// column-6 timing strip) and fills in unreserved modules from the bit
+// stream of codewords.
+func qrPlaceData(matrix [][]bool, reserved [][]bool, size int, codewords []byte) {
+	bitIndex := 0
+	totalBits := len(codewords) * 8
+	nextBit := func() bool {
+		if bitIndex >= totalBits {
+			return false
+		}
+		b := codewords[bitIndex/8]
+		bit := (b >> uint(7-bitIndex%8)) & 1
+		bitIndex++
+		return bit == 1
+	}
+	col := size - 1
+	up := true
+	for col > 0 {
+		if col == 6 {
+			col--
+		}
+		if up {
+			for row := size - 1; row >= 0; row-- {
+				col1, col2 := col, col-1
+				if !reserved[row][col1] {
+					matrix[row][col1] = nextBit()
+				}
+				if col2 >= 0 && !reserved[row][col2] {
+					matrix[row][col2] = nextBit()
+				}
+			}
+		} else {
+			for row := 0; row < size; row++ {
+				col1, col2 := col, col-1
+				if !reserved[row][col1] {
+					matrix[row][col1] = nextBit()
+				}
+				if col2 >= 0 && !reserved[row][col2] {
+					matrix[row][col2] = nextBit()
+				}
+			}
+		}
+		up = !up
+		col -= 2
+	}
+}
+
+// qrApplyMask XORs data modules (mask pattern 0: (row+col) even) to
+// break up visual patterns that confuse scanners.
+func qrApplyMask(matrix [][]bool, reserved [][]bool, size int) {
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			if reserved[row][col] {
+				continue
+			}
+			if (row+col)%2 == 0 {
+				matrix[row][col] = !matrix[row][col]
+			}
+		}
+	}
+}
+
+// qrPlaceFormat computes and writes the 15-bit format information
+// (error correction level + mask pattern, BCH-encoded and XORed with
+// the fixed mask) into both of its locations around the finder
+// patterns, for mask pattern 0.
+func qrPlaceFormat(matrix [][]bool, size int, level uint) {
+	data := (level << 3) // mask pattern 0
+	bch := data << 10
+	gen := uint(0x537)
+	rem := bch
+	for i := 14; i >= 10; i-- {
+		if rem&(1<<uint(i)) != 0 {
+			rem ^= gen << uint(i-10)
+		}
+	}
+	format := ((data << 10) | rem) ^ 0x5412
+	bit := func(i int) bool { return format&(1<<uint(i)) != 0 }
+
+	for i := 0; i <= 5; i++ {
+		matrix[i][8] = bit(i)
+	}
+	matrix[7][8] = bit(6)
+	matrix[8][8] = bit(7)
+	matrix[8][7] = bit(8)
+	for i := 9; i <= 14; i++ {
+		matrix[8][14-i] = bit(i)
+	}
+
+	for i := 0; i <= 7; i++ {
+		matrix[8][size-1-i] = bit(i)
+	}
+	for i := 8; i <= 14; i++ {
+		matrix[size-15+i][8] = bit(i)
+	}
+	matrix[size-8][8] = true
+}
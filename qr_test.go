@@ -0,0 +1,71 @@
+package svg
+
+import "testing"
+
+// TestGF256Exp checks the GF(256) power table against hand-derived
+// values for the QR code field polynomial x^8+x^4+x^3+x^2+1 (0x11d):
+// the first eight powers of alpha=2 are powers of two with no
+// reduction, and alpha^8 is the first to require reducing by the
+// field polynomial (256 = 0x100, 0x100 XOR 0x11d = 0x01d = 29).
+func TestGF256Exp(t *testing.T) {
+	for i, want := range []byte{1, 2, 4, 8, 16, 32, 64, 128, 29} {
+		if qrExp[i] != want {
+			t.Fatalf("qrExp[%d] = %d, want %d", i, qrExp[i], want)
+		}
+	}
+}
+
+// TestRSEncodeUnit checks the Reed-Solomon step against a minimal,
+// hand-derivable case: encoding the single data byte 1 with 2 ECC
+// codewords is, by construction of the encoder (XOR with generator*1
+// leaves the generator's own coefficients), just the n=2 generator
+// polynomial's non-leading coefficients, [3, 2] (computed by
+// convolving [1] with (x+1) then with (x+alpha) over GF(256)).
+func TestRSEncodeUnit(t *testing.T) {
+	got := rsEncode([]byte{1}, 2)
+	want := []byte{3, 2}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("rsEncode([1], 2) = %v, want %v", got, want)
+	}
+}
+
+// TestQRCodewordsRoundTrip verifies that the bit-level structure
+// produced by qrCodewords (mode indicator, character count, data
+// bytes) can be read back byte for byte, since qrEncode's correctness
+// as a whole cannot be checked without a full QR decoder.
+func TestQRCodewordsRoundTrip(t *testing.T) {
+	data := "HELLO"
+	blk := qrblocks["1M"]
+	codewords := qrCodewords(data, blk)
+	if len(codewords) != blk.data {
+		t.Fatalf("qrCodewords returned %d bytes, want %d", len(codewords), blk.data)
+	}
+
+	mode := codewords[0] >> 4
+	if mode != 0x4 {
+		t.Fatalf("mode indicator = %x, want 4 (byte mode)", mode)
+	}
+	count := (codewords[0]&0xf)<<4 | codewords[1]>>4
+	if int(count) != len(data) {
+		t.Fatalf("character count = %d, want %d", count, len(data))
+	}
+	for i := 0; i < len(data); i++ {
+		shifted := (codewords[1+i]&0x0f)<<4 | codewords[2+i]>>4
+		if shifted != data[i] {
+			t.Fatalf("byte %d = %x, want %x", i, shifted, data[i])
+		}
+	}
+}
+
+// TestQRFit checks that the smallest capable version is chosen, and
+// that payloads too large for the supported version/level range are
+// rejected rather than silently corrupted.
+func TestQRFit(t *testing.T) {
+	if v, _ := qrFit("short", "L"); v != 1 {
+		t.Fatalf("qrFit(short, L) version = %d, want 1", v)
+	}
+	long := make([]byte, 200)
+	if v, _ := qrFit(string(long), "L"); v != 0 {
+		t.Fatalf("qrFit(200 bytes, L) version = %d, want 0 (unsupported)", v)
+	}
+}
@@ -90,6 +90,28 @@ func (svg *SVG) Use(x int, y int, link string, s ...string) {
 	svg.printf(`<use %s %s %s`, svg.loc(x, y), svg.href(link), svg.endstyle(s))
 }
 
+// Symbol begins a reusable graphic definition with the specified id.
+// Symbol content is added between Symbol and SymbolEnd, and is
+// referenced elsewhere with Use. Elements of s are either a single
+// style string or name="value" attribute pairs (e.g. viewBox="0 0 45 45"),
+// following the same convention as endstyle.
+// Standard Reference: http://www.w3.org/TR/SVG11/struct.html#SymbolElement
+func (svg *SVG) Symbol(id string, s ...string) {
+	attrs, style := "", ""
+	for _, v := range s {
+		if strings.Index(v, "=") > 0 {
+			attrs += v + " "
+		} else {
+			style = v
+		}
+	}
+	svg.printf(`<symbol id="%s" %s %s>`, id, attrs, svg.style(style))
+	svg.println()
+}
+
+// SymbolEnd ends a symbol definition.
+func (svg *SVG) SymbolEnd() { svg.println(`</symbol>`) }
+
 // Shapes
 
 // Circle centered at x,y, with radius r, with optional style.
@@ -0,0 +1,81 @@
+package svg
+
+import "fmt"
+
+// ViewBox describes the region of the user coordinate system mapped
+// onto the SVG viewport, for use with StartView and StartUnit.
+// Standard Reference: http://www.w3.org/TR/SVG11/coords.html#ViewBoxAttribute
+type ViewBox struct {
+	X float
+	Y float
+	W float
+	H float
+}
+
+// Length is a CSS length: a value paired with a unit ("px", "pt",
+// "mm", "cm", "in", "pc", or "%"). An empty Unit is equivalent to "px".
+type Length struct {
+	Value float
+	Unit  string
+}
+
+func (l Length) String() string { return fmt.Sprintf("%g%s", l.Value, l.Unit) }
+
+const svgviewinit = `<?xml version="1.0"?>
+<svg xmlns="http://www.w3.org/2000/svg"
+     xmlns:xlink="http://www.w3.org/1999/xlink"
+     width="%d" height="%d" viewBox="%g %g %g %g" preserveAspectRatio="%s">
+<!-- Generated by SVGo -->
+`
+
+const svgunitinit = `<?xml version="1.0"?>
+<svg xmlns="http://www.w3.org/2000/svg"
+     xmlns:xlink="http://www.w3.org/1999/xlink"
+     width="%s" height="%s" viewBox="%g %g %g %g">
+<!-- Generated by SVGo -->
+`
+
+// StartView begins the SVG document with pixel width w and height h,
+// mapping the coordinate region described by viewBox onto the
+// viewport according to preserveAspectRatio (e.g. "xMidYMid meet"),
+// so the document scales responsively when embedded.
+// Standard Reference: http://www.w3.org/TR/SVG11/coords.html#ViewBoxAttribute
+func (svg *SVG) StartView(w int, h int, viewBox ViewBox, preserveAspectRatio string) {
+	svg.printf(svgviewinit, w, h, viewBox.X, viewBox.Y, viewBox.W, viewBox.H, preserveAspectRatio)
+}
+
+// StartUnit begins the SVG document with width w and height h
+// expressed in the given CSS length unit (e.g. "mm", "in"), mapping
+// viewBox onto the viewport, so print-oriented documents can be
+// authored in physical units while remaining valid SVG.
+// Standard Reference: http://www.w3.org/TR/SVG11/coords.html#Units
+func (svg *SVG) StartUnit(w float, h float, unit string, viewBox ViewBox) {
+	svg.printf(svgunitinit,
+		Length{w, unit}, Length{h, unit}, viewBox.X, viewBox.Y, viewBox.W, viewBox.H)
+}
+
+// Circlef centered at x,y, with radius r, with optional style. Unlike
+// Circle, the coordinates are floats so callers can author in the
+// fractional units established by StartUnit/StartView.
+func (svg *SVG) Circlef(x float, y float, r float, s ...string) {
+	svg.printf(`<circle cx="%g" cy="%g" r="%g" %s`, x, y, r, svg.endstyle(s))
+}
+
+// Rectf draws a rectangle with upper left-hand corner at x,y, with
+// width w, and height h, with optional style. Unlike Rect, the
+// coordinates are floats.
+func (svg *SVG) Rectf(x float, y float, w float, h float, s ...string) {
+	svg.printf(`<rect x="%g" y="%g" width="%g" height="%g" %s`, x, y, w, h, svg.endstyle(s))
+}
+
+// Ellipsef centered at x,y with radii rx, ry, with optional style.
+// Unlike Ellipse, the coordinates are floats.
+func (svg *SVG) Ellipsef(x float, y float, rx float, ry float, s ...string) {
+	svg.printf(`<ellipse cx="%g" cy="%g" rx="%g" ry="%g" %s`, x, y, rx, ry, svg.endstyle(s))
+}
+
+// Linef draws a straight line between two points, with optional
+// style. Unlike Line, the coordinates are floats.
+func (svg *SVG) Linef(x1 float, y1 float, x2 float, y2 float, s ...string) {
+	svg.printf(`<line x1="%g" y1="%g" x2="%g" y2="%g" %s`, x1, y1, x2, y2, svg.endstyle(s))
+}